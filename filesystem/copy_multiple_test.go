@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2025. Encore Digital Group.
+ * All Right Reserved.
+ */
+
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestCopyMultipleSources_NoDataRace guards the default CopyMultipleSources path, where every
+// source's worker pool reports through the same MultiSourceReporter concurrently. Run with
+// -race, this catches a reporter that touches its shared state from more than one source's
+// goroutine without synchronization.
+func TestCopyMultipleSources_NoDataRace(t *testing.T) {
+	var sources []string
+	for i := 0; i < 4; i++ {
+		source := t.TempDir()
+		for j := 0; j < 5; j++ {
+			name := filepath.Join(source, fmt.Sprintf("file%d.txt", j))
+			if err := os.WriteFile(name, []byte("hello"), 0644); err != nil {
+				t.Fatalf("writing source file: %v", err)
+			}
+		}
+		sources = append(sources, source)
+	}
+
+	destination := t.TempDir()
+
+	cd := &CopyDirectory{}
+	count, err := cd.CopyMultipleSources(sources, destination)
+	if err != nil {
+		t.Fatalf("CopyMultipleSources: %v", err)
+	}
+	if count != 20 {
+		t.Fatalf("CopyMultipleSources copied %d files, want 20", count)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2025. Encore Digital Group.
+ * All Right Reserved.
+ */
+
+package filesystem
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// copySource abstracts where a copy's files come from, so copyTree and doCopy have a single
+// walker and a single per-file copy implementation shared by the OS-backed entry points
+// (CopyFilesAndDirectory, Sync, Copy) and CopyFromFS, instead of two that can drift apart.
+type copySource interface {
+	// ReadDir lists the entries directly under path.
+	ReadDir(path string) ([]fs.DirEntry, error)
+
+	// Stat returns file info for path, without following a trailing symlink when the source
+	// can tell the difference between a symlink and the file it points to.
+	Stat(path string) (fs.FileInfo, error)
+
+	// Open opens path for reading, following a trailing symlink.
+	Open(path string) (io.ReadCloser, error)
+
+	// Join joins a directory and a child name using the source's own path convention.
+	Join(dir, name string) string
+}
+
+// symlinkSource is implemented by copySource implementations that can represent a symlink
+// distinctly from the file it points to. Only the local filesystem can; an fs.FS has no
+// notion of a symlink, so opts.FollowSymlinks has no effect on CopyFromFS.
+type symlinkSource interface {
+	Readlink(path string) (string, error)
+}
+
+// hardlinkSource is implemented by copySource implementations where a destination can be
+// linked to the source instead of copied. Only the local filesystem can; opts.Hardlink has no
+// effect on CopyFromFS.
+type hardlinkSource interface {
+	Link(sourcePath, destinationPath string) error
+}
+
+// followStatSource is implemented by copySource implementations whose Stat doesn't already
+// follow a trailing symlink, so a second, following stat is needed after a symlink's target has
+// been copied, to preserve the target's mode and mtime rather than the symlink's own.
+type followStatSource interface {
+	StatFollow(path string) (fs.FileInfo, error)
+}
+
+// osSource is the copySource backing CopyFilesAndDirectory, Sync, and Copy: the local
+// filesystem, addressed by native paths.
+type osSource struct{}
+
+func (osSource) ReadDir(dir string) ([]fs.DirEntry, error) { return os.ReadDir(dir) }
+func (osSource) Stat(path string) (fs.FileInfo, error)     { return os.Lstat(path) }
+func (osSource) Open(path string) (io.ReadCloser, error)   { return os.Open(path) }
+func (osSource) Join(dir, name string) string              { return filepath.Join(dir, name) }
+func (osSource) Readlink(path string) (string, error)      { return os.Readlink(path) }
+func (osSource) StatFollow(path string) (fs.FileInfo, error) {
+	return os.Stat(path)
+}
+func (osSource) Link(sourcePath, destinationPath string) error {
+	return os.Link(sourcePath, destinationPath)
+}
+
+// fsSource is the copySource backing CopyFromFS: an arbitrary fs.FS, addressed by
+// slash-separated paths per io/fs convention.
+type fsSource struct {
+	fsys fs.FS
+}
+
+func (s fsSource) ReadDir(dir string) ([]fs.DirEntry, error) { return fs.ReadDir(s.fsys, dir) }
+func (s fsSource) Stat(p string) (fs.FileInfo, error)        { return fs.Stat(s.fsys, p) }
+func (s fsSource) Open(p string) (io.ReadCloser, error)      { return s.fsys.Open(p) }
+func (s fsSource) Join(dir, name string) string              { return path.Join(dir, name) }
@@ -6,16 +6,124 @@
 package filesystem
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"hash"
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sync"
+	"time"
 
-	"github.com/schollz/progressbar/v3"
+	"github.com/EncoreDigitalGroup/golib/filesystem/metrics"
+	"github.com/EncoreDigitalGroup/golib/logger"
 )
 
-type CopyDirectory struct {
+// SkipPolicy controls which files Sync considers already up to date and
+// therefore skips re-copying.
+type SkipPolicy int
+
+const (
+	// SkipPolicyAlways never skips a file; every file is copied, overwriting
+	// the destination. This is the zero value and matches Copy's behavior.
+	SkipPolicyAlways SkipPolicy = iota
+
+	// SkipPolicySizeAndMTime skips a file when the destination already
+	// exists with the same size and modification time as the source.
+	SkipPolicySizeAndMTime
+
+	// SkipPolicyChecksum skips a file only when the destination already
+	// exists and its hash, per Options.Hasher, matches the source's.
+	SkipPolicyChecksum
+)
+
+// Options configures how a CopyDirectory operation is carried out.
+type Options struct {
+	// Concurrency is the number of worker goroutines used to copy files.
+	// Defaults to runtime.NumCPU() when left at zero.
+	Concurrency int
+
+	// BufferSize is the size, in bytes, of the buffer used when copying a
+	// single file. Defaults to 1MB when left at zero.
 	BufferSize int
+
+	// PreserveMode copies the source file's permission bits onto the
+	// destination file after it has been written.
+	PreserveMode bool
+
+	// PreserveTimes copies the source file's modification time onto the
+	// destination file after it has been written.
+	PreserveTimes bool
+
+	// FollowSymlinks controls whether symlinks are followed (copying the
+	// file they point to) or recreated as symlinks at the destination.
+	// Defaults to true; pass a pointer to false to preserve symlinks
+	// as-is instead of dereferencing them.
+	FollowSymlinks *bool
+
+	// Hardlink, when true, attempts to hardlink the destination to the
+	// source via os.Link before falling back to a regular copy. It is not
+	// attempted under SkipPolicyChecksum, which always writes through a
+	// temporary file.
+	Hardlink bool
+
+	// PreferConcurrent decides, per directory, whether the files it
+	// contains are dispatched to the worker pool or copied inline by the
+	// walker goroutine. When nil, every directory is processed
+	// concurrently.
+	PreferConcurrent func(path string) bool
+
+	// SkipPolicy determines when a copy considers a file already up to date
+	// and leaves it alone. Every entry point honors it; Sync additionally
+	// treats SkipPolicyAlways as SkipPolicySizeAndMTime.
+	SkipPolicy SkipPolicy
+
+	// Hasher constructs the hash.Hash used by SkipPolicyChecksum. Defaults
+	// to sha256.New.
+	Hasher func() hash.Hash
+
+	// Reporter receives progress updates as files are copied. Left nil, Copy
+	// uses a terminal progress bar, CopyMultipleSources a MultiSourceReporter,
+	// and CopyFilesAndDirectory, Sync, and CopyFromFS report nothing.
+	Reporter ProgressReporter
+
+	// Metrics, when set, receives Prometheus instrumentation for every file
+	// copied. Construct one with metrics.NewMetrics per CopyDirectory
+	// instance that needs its own series. Left nil, no metrics are recorded.
+	Metrics *metrics.Metrics
+
+	// Logger, when set, receives structured events for every file copied:
+	// a Debug event on success, a Warn event when a hardlink falls back to
+	// a regular copy, and an Error event on failure. Left nil, the copy
+	// subsystem stays silent.
+	Logger *logger.Logger
+}
+
+func (o Options) withDefaults() Options {
+	if o.Concurrency <= 0 {
+		o.Concurrency = runtime.NumCPU()
+	}
+	if o.BufferSize <= 0 {
+		o.BufferSize = 1024 * 1024 // 1MB buffer
+	}
+	if o.FollowSymlinks == nil {
+		followSymlinks := true
+		o.FollowSymlinks = &followSymlinks
+	}
+	if o.Hasher == nil {
+		o.Hasher = sha256.New
+	}
+	if o.Reporter == nil {
+		o.Reporter = NoopReporter{}
+	}
+	return o
+}
+
+type CopyDirectory struct {
+	Options
 }
 
 // CountFiles recursively counts the total number of files in a directory tree
@@ -39,168 +147,603 @@ func (cd *CopyDirectory) CountFiles(directory string) (int, error) {
 	return count, nil
 }
 
-// CopyFilesAndDirectory copies files and directories, sending progress updates via a channel
-func (cd *CopyDirectory) CopyFilesAndDirectory(sourceDirectory, destinationDirectory string, progressChan chan struct{}) (int, error) {
-	// Check if the destination exists
-	if _, err := os.Stat(destinationDirectory); os.IsNotExist(err) {
-		err = os.MkdirAll(destinationDirectory, 0755)
-		if err != nil {
-			return 0, err
+// totalBytes recursively sums the size of every file under directory in src, for sizing a
+// ProgressReporter's total up front.
+func (cd *CopyDirectory) totalBytes(src copySource, directory string) (int64, error) {
+	entries, err := src.ReadDir(directory)
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, entry := range entries {
+		if entry.IsDir() {
+			subTotal, err := cd.totalBytes(src, src.Join(directory, entry.Name()))
+			if err != nil {
+				return 0, err
+			}
+			total += subTotal
+		} else {
+			info, err := entry.Info()
+			if err != nil {
+				return 0, err
+			}
+			total += info.Size()
 		}
 	}
+	return total, nil
+}
 
-	// Get all files and subdirectories from the source directory
-	entries, err := os.ReadDir(sourceDirectory)
+// copyJob describes a single file copy to be performed by a worker.
+type copyJob struct {
+	sourcePath      string
+	destinationPath string
+}
+
+// CopyFilesAndDirectory copies files and directories, sending progress updates via a channel.
+// Directory traversal happens serially on the calling goroutine; file copies are dispatched
+// onto a bounded pool of cd.Concurrency workers so deep trees no longer spawn one goroutine
+// per subdirectory.
+func (cd *CopyDirectory) CopyFilesAndDirectory(sourceDirectory, destinationDirectory string, progressChan chan struct{}) (int, error) {
+	return cd.copyTree(context.Background(), osSource{}, sourceDirectory, destinationDirectory, progressChan, cd.Options.withDefaults())
+}
+
+// Sync copies source into destination, skipping any file that already exists at the
+// destination and satisfies cd.SkipPolicy. SkipPolicy defaults to SkipPolicySizeAndMTime
+// for Sync, since SkipPolicyAlways (the Options zero value) would make Sync behave like
+// a plain Copy. This gives rsync-like incremental copies across repeated runs.
+//
+// SkipPolicySizeAndMTime compares the destination's mtime against the source's, so Sync
+// forces PreserveTimes on whenever that policy is in effect; otherwise every file would be
+// written with a fresh mtime on each run and the size/mtime comparison would never match on
+// a later run, defeating Sync's incremental behavior.
+func (cd *CopyDirectory) Sync(source, destination string) (int, error) {
+	opts := cd.Options.withDefaults()
+	if opts.SkipPolicy == SkipPolicyAlways {
+		opts.SkipPolicy = SkipPolicySizeAndMTime
+	}
+	if opts.SkipPolicy == SkipPolicySizeAndMTime {
+		opts.PreserveTimes = true
+	}
+
+	return cd.copyTree(context.Background(), osSource{}, source, destination, nil, opts)
+}
+
+// copyTree drives the walk-and-copy worker pool shared by CopyFilesAndDirectory, Sync, Copy,
+// and CopyFromFS; src is the local filesystem for the first three and an arbitrary fs.FS for
+// the last. Cancelling ctx aborts in-flight work in bounded time; the returned error will
+// satisfy errors.Is(err, ctx.Err()) alongside any I/O errors already collected.
+func (cd *CopyDirectory) copyTree(ctx context.Context, src copySource, sourceDirectory, destinationDirectory string, progressChan chan struct{}, opts Options) (int, error) {
+	total, err := cd.totalBytes(src, sourceDirectory)
 	if err != nil {
 		return 0, err
 	}
+	opts.Reporter.Start(total)
+	defer opts.Reporter.Finish()
 
-	// Use a WaitGroup to track directory copying operations
-	var waitGroup sync.WaitGroup
-
-	// Track errors from goroutines
-	var errorMutex sync.Mutex
-	var firstErr error
+	jobs := make(chan copyJob)
+	var workerGroup sync.WaitGroup
 
-	// Track file counts
-	var fileCount int
 	var countMutex sync.Mutex
+	var fileCount int
 
-	// Copy all files and subdirectories from the source directory
-	for _, entry := range entries {
-		sourcePath := filepath.Join(sourceDirectory, entry.Name())
-		destinationPath := filepath.Join(destinationDirectory, entry.Name())
+	var errMutex sync.Mutex
+	var copyErrors []error
+
+	for i := 0; i < opts.Concurrency; i++ {
+		workerGroup.Add(1)
+		go func() {
+			defer workerGroup.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job, ok := <-jobs:
+					if !ok {
+						return
+					}
+					copied, err := cd.copyFile(ctx, src, job.sourcePath, job.destinationPath, opts)
+					if err != nil {
+						errMutex.Lock()
+						copyErrors = append(copyErrors, err)
+						errMutex.Unlock()
+						continue
+					}
 
-		if entry.IsDir() {
-			// Handle subdirectories concurrently
-			waitGroup.Add(1)
-			go func(src, dst string) {
-				defer waitGroup.Done()
-				count, err := cd.CopyFilesAndDirectory(src, dst, progressChan)
-				countMutex.Lock()
-				fileCount += count
-				countMutex.Unlock()
-
-				if err != nil {
-					errorMutex.Lock()
-					if firstErr == nil {
-						firstErr = err
+					if copied {
+						countMutex.Lock()
+						fileCount++
+						countMutex.Unlock()
+					}
+					opts.Reporter.Add(0, 1)
+
+					if progressChan != nil {
+						progressChan <- struct{}{}
 					}
-					errorMutex.Unlock()
 				}
-			}(sourcePath, destinationPath)
-		} else {
-			// Copy file with optimized buffer
-			sourceFile, err := os.Open(sourcePath)
-			if err != nil {
-				return fileCount, err
 			}
+		}()
+	}
 
-			// Create destination file (overwrite if exists)
-			destinationFile, err := os.Create(destinationPath)
-			if err != nil {
-				_ = sourceFile.Close()
-				return fileCount, err
+	walkErr := cd.walk(ctx, src, sourceDirectory, destinationDirectory, opts, jobs, func(sourcePath, destinationPath string) {
+		// Directory did not prefer concurrent copying; copy inline on the walker goroutine.
+		copied, err := cd.copyFile(ctx, src, sourcePath, destinationPath, opts)
+		if err != nil {
+			errMutex.Lock()
+			copyErrors = append(copyErrors, err)
+			errMutex.Unlock()
+			return
+		}
+
+		if copied {
+			countMutex.Lock()
+			fileCount++
+			countMutex.Unlock()
+		}
+		opts.Reporter.Add(0, 1)
+
+		if progressChan != nil {
+			progressChan <- struct{}{}
+		}
+	})
+
+	close(jobs)
+	workerGroup.Wait()
+
+	if walkErr != nil {
+		copyErrors = append(copyErrors, walkErr)
+	}
+	if ctx.Err() != nil {
+		copyErrors = append(copyErrors, ctx.Err())
+	}
+
+	return fileCount, errors.Join(copyErrors...)
+}
+
+// walk traverses sourceDirectory in src, recreating its structure under destinationDirectory
+// on the local filesystem and either enqueueing files onto jobs or handing them to copyInline,
+// depending on opts.PreferConcurrent. It stops descending as soon as ctx is done.
+func (cd *CopyDirectory) walk(ctx context.Context, src copySource, sourceDirectory, destinationDirectory string, opts Options, jobs chan<- copyJob, copyInline func(sourcePath, destinationPath string)) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(destinationDirectory); os.IsNotExist(err) {
+		if err := os.MkdirAll(destinationDirectory, 0755); err != nil {
+			return err
+		}
+	}
+
+	entries, err := src.ReadDir(sourceDirectory)
+	if err != nil {
+		return err
+	}
+
+	concurrent := true
+	if opts.PreferConcurrent != nil {
+		concurrent = opts.PreferConcurrent(sourceDirectory)
+	}
+
+	var walkErrors []error
+	for _, entry := range entries {
+		sourcePath := src.Join(sourceDirectory, entry.Name())
+		destinationPath := filepath.Join(destinationDirectory, entry.Name())
+
+		if entry.IsDir() {
+			if err := cd.walk(ctx, src, sourcePath, destinationPath, opts, jobs, copyInline); err != nil {
+				walkErrors = append(walkErrors, err)
 			}
+			continue
+		}
 
-			// Use buffered copy for better performance
-			bufSize := 1024 * 1024 // 1MB buffer
-			if cd.BufferSize > 0 {
-				bufSize = cd.BufferSize
+		if concurrent {
+			select {
+			case jobs <- copyJob{sourcePath: sourcePath, destinationPath: destinationPath}:
+			case <-ctx.Done():
+				return errors.Join(append(walkErrors, ctx.Err())...)
 			}
-			buf := make([]byte, bufSize)
-			_, err = io.CopyBuffer(destinationFile, sourceFile, buf)
+		} else {
+			copyInline(sourcePath, destinationPath)
+		}
+	}
 
-			_ = sourceFile.Close()
-			_ = destinationFile.Close()
+	return errors.Join(walkErrors...)
+}
 
-			if err != nil {
-				return fileCount, err
-			}
+// copyError tags an error with the stage of the copy that produced it, so metrics can
+// report copy_errors_total by kind.
+type copyError struct {
+	kind string
+	err  error
+}
 
-			// Send progress update instead of logging
-			progressChan <- struct{}{}
-			countMutex.Lock()
-			fileCount++
-			countMutex.Unlock()
+func (e *copyError) Error() string { return e.err.Error() }
+func (e *copyError) Unwrap() error { return e.err }
+
+func copyErrorKind(err error) string {
+	var ce *copyError
+	if errors.As(err, &ce) {
+		return ce.kind
+	}
+	return "io"
+}
+
+// copyFile copies a single file from sourcePath to destinationPath out of src, honoring opts,
+// and records Prometheus metrics and structured log events for the attempt when opts.Metrics
+// or opts.Logger are set. It aborts early if ctx is done before the copy starts. The
+// returned bool reports whether a file was actually written, as opposed to left alone by
+// opts.SkipPolicy.
+func (cd *CopyDirectory) copyFile(ctx context.Context, src copySource, sourcePath, destinationPath string, opts Options) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	return cd.instrument(sourcePath, destinationPath, opts, func() (bool, error) {
+		return cd.doCopy(ctx, src, sourcePath, destinationPath, opts)
+	})
+}
+
+// instrument runs doCopy, wrapping it with the Prometheus metrics and structured log events
+// common to every copy path (OS-backed or fs.FS-backed). Files left alone by opts.SkipPolicy
+// report copied=false and are excluded from FilesCopiedTotal/BytesCopiedTotal and the
+// "copied file" log event, so a no-op Sync run doesn't masquerade as one that copied
+// everything.
+func (cd *CopyDirectory) instrument(sourcePath, destinationPath string, opts Options, doCopy func() (bool, error)) (bool, error) {
+	if opts.Metrics != nil {
+		opts.Metrics.CopyConcurrencyInflight.Inc()
+		defer opts.Metrics.CopyConcurrencyInflight.Dec()
+	}
+
+	start := time.Now()
+	copied, err := doCopy()
+	duration := time.Since(start)
+
+	if opts.Metrics != nil {
+		opts.Metrics.CopyDurationSeconds.Observe(duration.Seconds())
+	}
+
+	if err != nil {
+		if opts.Metrics != nil {
+			opts.Metrics.CopyErrorsTotal.WithLabelValues(copyErrorKind(err)).Inc()
+		}
+		if opts.Logger != nil {
+			opts.Logger.Error("copy failed", "src", sourcePath, "dst", destinationPath, "err", err)
 		}
+		return false, err
 	}
 
-	// Wait for all directory copies to complete
-	waitGroup.Wait()
-	return fileCount, firstErr
+	if !copied {
+		if opts.Logger != nil {
+			opts.Logger.Debug("skipped file", "src", sourcePath, "dst", destinationPath)
+		}
+		return false, nil
+	}
+
+	var size int64
+	if info, statErr := os.Lstat(destinationPath); statErr == nil {
+		size = info.Size()
+	}
+
+	if opts.Metrics != nil {
+		opts.Metrics.FilesCopiedTotal.Inc()
+		opts.Metrics.BytesCopiedTotal.Add(float64(size))
+	}
+
+	if opts.Logger != nil {
+		opts.Logger.Debug("copied file", "src", sourcePath, "dst", destinationPath, "bytes", size, "duration", duration)
+	}
+
+	return true, nil
 }
 
-// Copy orchestrates the copying process with a progress bar
-func (cd *CopyDirectory) Copy(source, destination string) (int, error) {
-	// Count total files for progress bar initialization
-	totalFiles, err := cd.CountFiles(source)
+// doCopy performs the actual copy of a single file from sourcePath to destinationPath out of
+// src. src is the local filesystem for every entry point except CopyFromFS, where it's the
+// caller's fs.FS; opts.FollowSymlinks, opts.Hardlink, and the post-copy re-stat that follows a
+// symlink only take effect when src also implements symlinkSource/hardlinkSource/followStatSource, which only
+// the local filesystem does, since an fs.FS has no notion of a symlink or a hardlink. The
+// returned bool reports whether a file was written; it is false when opts.SkipPolicy left an
+// up-to-date destination alone.
+func (cd *CopyDirectory) doCopy(ctx context.Context, src copySource, sourcePath, destinationPath string, opts Options) (bool, error) {
+	sourceInfo, err := src.Stat(sourcePath)
 	if err != nil {
-		return 0, err
+		return false, err
 	}
 
-	// Initialize progress bar
-	bar := progressbar.NewOptions(totalFiles,
-		progressbar.OptionSetWidth(50),
-		progressbar.OptionSetDescription("Copying files: "),
-	)
+	if ss, ok := src.(symlinkSource); ok &&
+		opts.FollowSymlinks != nil && !*opts.FollowSymlinks && sourceInfo.Mode()&os.ModeSymlink != 0 {
+		target, err := ss.Readlink(sourcePath)
+		if err != nil {
+			return false, err
+		}
+		_ = os.Remove(destinationPath)
+		if err := os.Symlink(target, destinationPath); err != nil {
+			return false, err
+		}
+		opts.Reporter.Add(sourceInfo.Size(), 0)
+		return true, nil
+	}
 
-	// Channel for progress updates
-	progressChan := make(chan struct{})
+	// SkipPolicyChecksum needs the full content of both files to decide, so it's handled as
+	// its own path that tees the decision hash off the copy itself instead of hashing the
+	// source, hashing the destination, and then re-reading the source a second time to copy it.
+	if opts.SkipPolicy == SkipPolicyChecksum {
+		return cd.copyFileChecksum(ctx, src, sourcePath, destinationPath, sourceInfo, opts)
+	}
 
-	// Goroutine to update progress bar
-	go func() {
-		for range progressChan {
-			_ = bar.Add(1)
+	if opts.SkipPolicy != SkipPolicyAlways {
+		skip, err := cd.shouldSkip(func() (io.ReadCloser, error) { return src.Open(sourcePath) }, destinationPath, sourceInfo, opts)
+		if err != nil {
+			return false, err
 		}
-	}()
+		if skip {
+			return false, nil
+		}
+	}
 
-	// Perform the copy operation
-	fileCount, err := cd.CopyFilesAndDirectory(source, destination, progressChan)
-	close(progressChan) // Close channel after copying is done
+	if opts.Hardlink {
+		if hs, ok := src.(hardlinkSource); ok {
+			_ = os.Remove(destinationPath)
+			if err := hs.Link(sourcePath, destinationPath); err == nil {
+				opts.Reporter.Add(sourceInfo.Size(), 0)
+				return true, nil
+			} else if opts.Logger != nil {
+				opts.Logger.Warn("hardlink failed, falling back to copy", "src", sourcePath, "dst", destinationPath, "err", err)
+			}
+			// Fall back to a regular copy below.
+		}
+	}
 
+	sourceFile, err := src.Open(sourcePath)
 	if err != nil {
-		return fileCount, err
+		return false, &copyError{kind: "open", err: err}
 	}
+	defer func() { _ = sourceFile.Close() }()
 
-	// Finish the progress bar
-	_ = bar.Finish()
-	return fileCount, nil
-}
+	destinationFile, err := os.Create(destinationPath)
+	if err != nil {
+		return false, &copyError{kind: "create", err: err}
+	}
+	defer func() { _ = destinationFile.Close() }()
 
-// CopyMultipleSources copies multiple sources to a destination with progress
-func (cd *CopyDirectory) CopyMultipleSources(sources []string, destination string) (int, error) {
-	// Count total files across all sources first
-	totalFiles := 0
-	for _, source := range sources {
-		count, err := cd.CountFiles(source)
+	destinationWriter := io.Writer(destinationFile)
+	if opts.Reporter != nil {
+		destinationWriter = &countingWriter{writer: destinationFile, reporter: opts.Reporter}
+	}
+
+	buf := make([]byte, opts.BufferSize)
+	if _, err := io.CopyBuffer(destinationWriter, &ctxReader{ctx: ctx, reader: sourceFile}, buf); err != nil {
+		return false, &copyError{kind: "io", err: err}
+	}
+
+	// sourceInfo was fetched with Stat, which doesn't follow a trailing symlink; when src can
+	// tell the two apart, re-stat following the link so PreserveMode/PreserveTimes below copy
+	// the target's attributes rather than the symlink's own.
+	if fss, ok := src.(followStatSource); ok {
+		sourceInfo, err = fss.StatFollow(sourcePath)
 		if err != nil {
-			return 0, err
+			return false, err
 		}
-		totalFiles += count
 	}
 
-	// Create a single progress bar for all sources
-	bar := progressbar.NewOptions(totalFiles,
-		progressbar.OptionSetWidth(50),
-		progressbar.OptionSetDescription("Copying files: "),
-	)
+	if opts.PreserveMode {
+		if err := os.Chmod(destinationPath, sourceInfo.Mode()); err != nil {
+			return false, err
+		}
+	}
+
+	if opts.PreserveTimes {
+		if err := os.Chtimes(destinationPath, sourceInfo.ModTime(), sourceInfo.ModTime()); err != nil {
+			return false, err
+		}
+	}
 
-	// Channel for progress updates from all copy operations
-	progressChan := make(chan struct{})
+	return true, nil
+}
 
-	// Goroutine to update progress bar
-	go func() {
-		for range progressChan {
-			_ = bar.Add(1)
+// copyFileChecksum implements SkipPolicyChecksum for copies from any copySource onto the local
+// filesystem. It hashes any
+// existing destination up front (one read of the destination), then streams sourcePath
+// through io.CopyBuffer into a temp file and opts.Hasher simultaneously via io.MultiWriter
+// (one read of the source), so content is read exactly once no matter the outcome. If the
+// resulting digest matches the destination's, the temp file is discarded and the copy is
+// skipped; otherwise the temp file is renamed into place, avoiding the second full read of
+// the source that a separate hash-then-copy pass would require.
+func (cd *CopyDirectory) copyFileChecksum(ctx context.Context, src copySource, sourcePath, destinationPath string, sourceInfo os.FileInfo, opts Options) (bool, error) {
+	var destinationSum []byte
+	if _, err := os.Stat(destinationPath); err == nil {
+		destinationSum, err = cd.hashFile(destinationPath, opts)
+		if err != nil {
+			return false, err
 		}
+	} else if !os.IsNotExist(err) {
+		return false, err
+	}
+
+	sourceFile, err := src.Open(sourcePath)
+	if err != nil {
+		return false, &copyError{kind: "open", err: err}
+	}
+	defer func() { _ = sourceFile.Close() }()
+
+	tempFile, err := os.CreateTemp(filepath.Dir(destinationPath), ".sync-*.tmp")
+	if err != nil {
+		return false, &copyError{kind: "create", err: err}
+	}
+	tempPath := tempFile.Name()
+	defer func() {
+		_ = tempFile.Close()
+		_ = os.Remove(tempPath)
 	}()
 
+	destinationWriter := io.Writer(tempFile)
+	if opts.Reporter != nil {
+		destinationWriter = &countingWriter{writer: tempFile, reporter: opts.Reporter}
+	}
+
+	hasher := opts.Hasher()
+	buf := make([]byte, opts.BufferSize)
+	if _, err := io.CopyBuffer(io.MultiWriter(destinationWriter, hasher), &ctxReader{ctx: ctx, reader: sourceFile}, buf); err != nil {
+		return false, &copyError{kind: "io", err: err}
+	}
+
+	if destinationSum != nil && bytes.Equal(hasher.Sum(nil), destinationSum) {
+		return false, nil
+	}
+
+	if err := tempFile.Close(); err != nil {
+		return false, &copyError{kind: "io", err: err}
+	}
+	if err := os.Rename(tempPath, destinationPath); err != nil {
+		return false, &copyError{kind: "io", err: err}
+	}
+
+	if opts.PreserveMode {
+		if err := os.Chmod(destinationPath, sourceInfo.Mode()); err != nil {
+			return false, err
+		}
+	}
+	if opts.PreserveTimes {
+		if err := os.Chtimes(destinationPath, sourceInfo.ModTime(), sourceInfo.ModTime()); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// countingWriter wraps an io.Writer and reports every write's size to a ProgressReporter,
+// so a single io.CopyBuffer call drives both the copy and its byte-level progress.
+type countingWriter struct {
+	writer   io.Writer
+	reporter ProgressReporter
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	if n > 0 {
+		w.reporter.Add(int64(n), 0)
+	}
+	return n, err
+}
+
+// ctxReader wraps an io.Reader and fails a Read as soon as ctx is done, bounding how long a
+// single large-file copy can run past cancellation to one buffer's worth of I/O.
+type ctxReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (r *ctxReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.reader.Read(p)
+}
+
+// shouldSkip decides, under opts.SkipPolicy, whether an already-present destination file
+// can be left alone instead of being overwritten by sourcePath.
+func (cd *CopyDirectory) shouldSkip(openSource func() (io.ReadCloser, error), destinationPath string, sourceInfo os.FileInfo, opts Options) (bool, error) {
+	destinationInfo, err := os.Stat(destinationPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	switch opts.SkipPolicy {
+	case SkipPolicySizeAndMTime:
+		return destinationInfo.Size() == sourceInfo.Size() && destinationInfo.ModTime().Equal(sourceInfo.ModTime()), nil
+	case SkipPolicyChecksum:
+		return cd.checksumsMatch(openSource, destinationPath, opts)
+	default:
+		return false, nil
+	}
+}
+
+// checksumsMatch hashes the source (opened via openSource) and destinationPath with
+// opts.Hasher and reports whether their digests are equal, streaming each through io.Copy
+// into the hasher rather than buffering it in memory.
+func (cd *CopyDirectory) checksumsMatch(openSource func() (io.ReadCloser, error), destinationPath string, opts Options) (bool, error) {
+	sourceSum, err := cd.hashReader(openSource, opts)
+	if err != nil {
+		return false, err
+	}
+
+	destinationSum, err := cd.hashFile(destinationPath, opts)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(sourceSum, destinationSum), nil
+}
+
+func (cd *CopyDirectory) hashFile(path string, opts Options) ([]byte, error) {
+	return cd.hashReader(func() (io.ReadCloser, error) { return os.Open(path) }, opts)
+}
+
+func (cd *CopyDirectory) hashReader(open func() (io.ReadCloser, error), opts Options) ([]byte, error) {
+	reader, err := open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = reader.Close() }()
+
+	hasher := opts.Hasher()
+	if _, err := io.Copy(hasher, reader); err != nil {
+		return nil, err
+	}
+
+	return hasher.Sum(nil), nil
+}
+
+// Copy orchestrates the copying process, reporting progress through cd.Reporter (a
+// terminal progress bar by default).
+func (cd *CopyDirectory) Copy(source, destination string) (int, error) {
+	return cd.CopyContext(context.Background(), source, destination)
+}
+
+// CopyContext is Copy with a caller-supplied context.Context. Cancelling ctx stops dispatching
+// new file copies and aborts in-flight ones in bounded time; the returned error will satisfy
+// errors.Is(err, ctx.Err()) alongside any I/O errors already collected, and the returned count
+// reflects however many files completed before cancellation.
+func (cd *CopyDirectory) CopyContext(ctx context.Context, source, destination string) (int, error) {
+	opts := cd.Options
+	if opts.Reporter == nil {
+		opts.Reporter = NewProgressBarReporter("Copying files: ")
+	}
+
+	return cd.copyTree(ctx, osSource{}, source, destination, nil, opts.withDefaults())
+}
+
+// CopyMultipleSources copies multiple sources to a destination, reporting progress through
+// cd.Reporter. By default it uses a MultiSourceReporter, rendering a single aggregate bar
+// across every source.
+func (cd *CopyDirectory) CopyMultipleSources(sources []string, destination string) (int, error) {
+	return cd.CopyMultipleSourcesContext(context.Background(), sources, destination)
+}
+
+// CopyMultipleSourcesContext is CopyMultipleSources with a caller-supplied context.Context.
+// Cancelling ctx propagates to every source's worker pool; the returned error will satisfy
+// errors.Is(err, ctx.Err()) alongside any I/O errors already collected.
+func (cd *CopyDirectory) CopyMultipleSourcesContext(ctx context.Context, sources []string, destination string) (int, error) {
+	var multi *MultiSourceReporter
+	if cd.Options.Reporter == nil {
+		multi = NewMultiSourceReporter(sources)
+
+		grandTotal := int64(0)
+		for _, source := range sources {
+			total, err := cd.totalBytes(osSource{}, source)
+			if err != nil {
+				return 0, err
+			}
+			grandTotal += total
+		}
+		multi.Start(grandTotal)
+	}
+
 	// Ensure destination exists
 	if _, err := os.Stat(destination); os.IsNotExist(err) {
-		err = os.MkdirAll(destination, 0755)
-		if err != nil {
-			close(progressChan)
+		if err := os.MkdirAll(destination, 0755); err != nil {
 			return 0, err
 		}
 	}
@@ -214,8 +757,16 @@ func (cd *CopyDirectory) CopyMultipleSources(sources []string, destination strin
 		waitGroup.Add(1)
 		go func(src string) {
 			defer waitGroup.Done()
-			// Use copyFilesAndDirectory instead of Copy to avoid creating multiple progress bars
-			count, err := cd.CopyFilesAndDirectory(src, destination, progressChan)
+
+			opts := cd.Options
+			if multi != nil {
+				opts.Reporter = multi.For(src)
+			}
+			if opts.Logger != nil {
+				opts.Logger = opts.Logger.With("source", src)
+			}
+
+			count, err := cd.copyTree(ctx, osSource{}, src, destination, nil, opts.withDefaults())
 			countChan <- count
 			if err != nil {
 				errChan <- err
@@ -225,21 +776,26 @@ func (cd *CopyDirectory) CopyMultipleSources(sources []string, destination strin
 
 	// Wait for all goroutines to finish
 	waitGroup.Wait()
-	close(progressChan)
 	close(errChan)
 	close(countChan)
 
+	if multi != nil {
+		multi.Finish()
+	}
+
 	// Calculate total files copied
 	totalFilesCopied := 0
 	for count := range countChan {
 		totalFilesCopied += count
 	}
 
-	_ = bar.Finish()
-
-	// Return first error if any
-	if len(errChan) > 0 {
-		return totalFilesCopied, <-errChan
+	// Collect all errors from the sources
+	var copyErrors []error
+	for err := range errChan {
+		copyErrors = append(copyErrors, err)
+	}
+	if len(copyErrors) > 0 {
+		return totalFilesCopied, errors.Join(copyErrors...)
 	}
 	return totalFilesCopied, nil
 }
@@ -0,0 +1,23 @@
+/*
+ * Copyright (c) 2025. Encore Digital Group.
+ * All Right Reserved.
+ */
+
+package filesystem
+
+import (
+	"context"
+	"io/fs"
+)
+
+// CopyFromFS copies root and everything under it out of src into destination on the local
+// filesystem. It accepts any fs.FS, such as an embed.FS or an os.DirFS, making it the way to
+// materialize assets embedded in a binary at startup. It shares copyTree with
+// CopyFilesAndDirectory, Sync, and Copy, addressing src through the same copySource
+// abstraction those use for the local filesystem, so the two don't drift apart. Concurrency,
+// BufferSize, Reporter, Metrics, and Logger all behave as they do for CopyFilesAndDirectory;
+// Hardlink and FollowSymlinks are OS-path-only and are ignored here, since fs.FS has no notion
+// of either.
+func (cd *CopyDirectory) CopyFromFS(src fs.FS, root, destination string) (int, error) {
+	return cd.copyTree(context.Background(), fsSource{fsys: src}, root, destination, nil, cd.Options.withDefaults())
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2025. Encore Digital Group.
+ * All Right Reserved.
+ */
+
+package filesystem
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+// TestCopyFromFS_WritesFileContent guards CopyFromFS's shared use of copyTree/doCopy: a file
+// in an arbitrary fs.FS must end up on disk with matching content, going through the same
+// walker and copy implementation as the OS-backed entry points.
+func TestCopyFromFS_WritesFileContent(t *testing.T) {
+	src := fstest.MapFS{
+		"assets/hello.txt":       {Data: []byte("hello")},
+		"assets/nested/data.bin": {Data: []byte("binary data")},
+	}
+
+	destination := t.TempDir()
+
+	cd := &CopyDirectory{Options: Options{Reporter: NoopReporter{}}}
+	count, err := cd.CopyFromFS(src, "assets", destination)
+	if err != nil {
+		t.Fatalf("CopyFromFS: %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("CopyFromFS copied %d files, want 2", count)
+	}
+
+	got, err := os.ReadFile(filepath.Join(destination, "nested", "data.bin"))
+	if err != nil {
+		t.Fatalf("reading copied file: %v", err)
+	}
+	if string(got) != "binary data" {
+		t.Fatalf("copied content = %q, want %q", got, "binary data")
+	}
+}
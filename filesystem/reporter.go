@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2025. Encore Digital Group.
+ * All Right Reserved.
+ */
+
+package filesystem
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/schollz/progressbar/v3"
+)
+
+// ProgressReporter receives progress updates from a copy operation. Implementations must be
+// safe for concurrent use, since Add is called from every worker goroutine.
+type ProgressReporter interface {
+	// Start is called once, before any files are copied, with the total number of bytes
+	// the operation expects to copy.
+	Start(total int64)
+
+	// Add reports bytes written and/or files completed since the last call. Either value
+	// may be zero; workers call it once per write chunk with files set to 0, and once more
+	// with bytes set to 0 when a file finishes.
+	Add(bytes int64, files int)
+
+	// Finish is called once, after every file has been copied.
+	Finish()
+}
+
+// NoopReporter is a ProgressReporter that discards every update. It's useful for headless
+// or CI contexts where a terminal progress bar isn't wanted.
+type NoopReporter struct{}
+
+func (NoopReporter) Start(int64)    {}
+func (NoopReporter) Add(int64, int) {}
+func (NoopReporter) Finish()        {}
+
+// progressBarReporter is the default ProgressReporter, backed by github.com/schollz/progressbar/v3.
+type progressBarReporter struct {
+	description string
+	bar         *progressbar.ProgressBar
+}
+
+// NewProgressBarReporter returns the default ProgressReporter: a single terminal progress
+// bar, labeled with description, that tracks bytes copied.
+func NewProgressBarReporter(description string) ProgressReporter {
+	return &progressBarReporter{description: description}
+}
+
+func (r *progressBarReporter) Start(total int64) {
+	r.bar = progressbar.NewOptions64(total,
+		progressbar.OptionSetWidth(50),
+		progressbar.OptionSetDescription(r.description),
+		progressbar.OptionShowBytes(true),
+		progressbar.OptionThrottle(100*time.Millisecond),
+	)
+}
+
+func (r *progressBarReporter) Add(bytes int64, _ int) {
+	if r.bar == nil || bytes == 0 {
+		return
+	}
+	_ = r.bar.Add64(bytes)
+}
+
+func (r *progressBarReporter) Finish() {
+	if r.bar != nil {
+		_ = r.bar.Finish()
+	}
+}
+
+// MultiSourceReporter is a ProgressReporter for CopyMultipleSources. Each source is copied by
+// its own worker pool running concurrently, and github.com/schollz/progressbar/v3 bars each
+// own the terminal's cursor position via \r, so rendering one bar per source would have them
+// fight over the same lines and garble the output. MultiSourceReporter instead renders a
+// single bar tracking bytes copied across every source combined.
+type MultiSourceReporter struct {
+	total *progressBarReporter
+}
+
+// NewMultiSourceReporter returns a MultiSourceReporter with a single aggregate bar covering
+// all of sources.
+func NewMultiSourceReporter(sources []string) *MultiSourceReporter {
+	return &MultiSourceReporter{
+		total: &progressBarReporter{description: fmt.Sprintf("Copying %d sources: ", len(sources))},
+	}
+}
+
+func (m *MultiSourceReporter) Start(total int64) {
+	m.total.Start(total)
+}
+
+func (m *MultiSourceReporter) Add(bytes int64, files int) {
+	m.total.Add(bytes, files)
+}
+
+func (m *MultiSourceReporter) Finish() {
+	m.total.Finish()
+}
+
+// For returns the ProgressReporter each source's worker pool should report through. Every
+// source's copyTree calls Start and Finish on whatever Reporter it's given, same as a
+// single-source copy, so For can't just return m: m's own Start/Finish must run exactly once,
+// driven by CopyMultipleSourcesContext itself, not once per source. It instead returns an
+// adapter that forwards Add to the shared aggregate bar and no-ops Start/Finish.
+func (m *MultiSourceReporter) For(source string) ProgressReporter {
+	return &multiSourceAdapter{total: m.total}
+}
+
+// multiSourceAdapter is the per-source ProgressReporter handed out by MultiSourceReporter.For.
+type multiSourceAdapter struct {
+	total *progressBarReporter
+}
+
+func (a *multiSourceAdapter) Start(int64) {}
+
+func (a *multiSourceAdapter) Add(bytes int64, files int) {
+	a.total.Add(bytes, files)
+}
+
+func (a *multiSourceAdapter) Finish() {}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2025. Encore Digital Group.
+ * All Right Reserved.
+ */
+
+package filesystem
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestSync_SecondRunCopiesNothing guards Sync's headline behavior: once a file has been
+// synced, a second run with no source changes must copy zero files. Sync's default
+// SkipPolicySizeAndMTime compares destination mtime against source mtime, so this only holds
+// if Sync also preserves the source's mtime on write.
+func TestSync_SecondRunCopiesNothing(t *testing.T) {
+	source := t.TempDir()
+	destination := filepath.Join(t.TempDir(), "dst")
+
+	if err := os.WriteFile(filepath.Join(source, "file.txt"), []byte("hello"), 0644); err != nil {
+		t.Fatalf("writing source file: %v", err)
+	}
+
+	cd := &CopyDirectory{Options: Options{Reporter: NoopReporter{}}}
+
+	firstCount, err := cd.Sync(source, destination)
+	if err != nil {
+		t.Fatalf("first Sync: %v", err)
+	}
+	if firstCount != 1 {
+		t.Fatalf("first Sync copied %d files, want 1", firstCount)
+	}
+
+	secondCount, err := cd.Sync(source, destination)
+	if err != nil {
+		t.Fatalf("second Sync: %v", err)
+	}
+	if secondCount != 0 {
+		t.Fatalf("second Sync copied %d files, want 0", secondCount)
+	}
+}
+
+// TestSync_ChecksumPolicySkipsUnchangedContent exercises the SkipPolicyChecksum path, where
+// the decision hash is teed off the copy write rather than computed in a separate pass: an
+// unchanged file must still be skipped, and a changed one must end up with the new content.
+func TestSync_ChecksumPolicySkipsUnchangedContent(t *testing.T) {
+	source := t.TempDir()
+	destination := filepath.Join(t.TempDir(), "dst")
+	sourceFile := filepath.Join(source, "file.txt")
+
+	if err := os.WriteFile(sourceFile, []byte("hello"), 0644); err != nil {
+		t.Fatalf("writing source file: %v", err)
+	}
+
+	cd := &CopyDirectory{Options: Options{Reporter: NoopReporter{}, SkipPolicy: SkipPolicyChecksum}}
+
+	if _, err := cd.Sync(source, destination); err != nil {
+		t.Fatalf("first Sync: %v", err)
+	}
+
+	secondCount, err := cd.Sync(source, destination)
+	if err != nil {
+		t.Fatalf("second Sync: %v", err)
+	}
+	if secondCount != 0 {
+		t.Fatalf("second Sync copied %d files, want 0", secondCount)
+	}
+
+	if err := os.WriteFile(sourceFile, []byte("goodbye"), 0644); err != nil {
+		t.Fatalf("updating source file: %v", err)
+	}
+
+	thirdCount, err := cd.Sync(source, destination)
+	if err != nil {
+		t.Fatalf("third Sync: %v", err)
+	}
+	if thirdCount != 1 {
+		t.Fatalf("third Sync copied %d files, want 1", thirdCount)
+	}
+
+	got, err := os.ReadFile(filepath.Join(destination, "file.txt"))
+	if err != nil {
+		t.Fatalf("reading destination file: %v", err)
+	}
+	if string(got) != "goodbye" {
+		t.Fatalf("destination content = %q, want %q", got, "goodbye")
+	}
+}
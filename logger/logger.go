@@ -6,6 +6,7 @@
 package logger
 
 import (
+	"context"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
 	"os"
@@ -74,3 +75,22 @@ func Warn(msg string, keyvals ...interface{}) {
 func Warnf(format string, args ...interface{}) {
 	Default.Warnf(format, args...)
 }
+
+// With returns a copy of l that prepends keyvals to every subsequent log call, e.g. to
+// attach a source= field to a logger handed to one worker.
+func (l *Logger) With(keyvals ...interface{}) *Logger {
+	return &Logger{l.Logger.With(keyvals...)}
+}
+
+// WithContext returns a copy of ctx carrying l, retrievable later with FromContext.
+func WithContext(ctx context.Context, l *Logger) context.Context {
+	return log.WithContext(ctx, l.Logger)
+}
+
+// FromContext returns the Logger stored in ctx by WithContext, or Default if none was stored.
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(log.ContextKey).(*log.Logger); ok {
+		return &Logger{l}
+	}
+	return Default
+}